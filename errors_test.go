@@ -0,0 +1,64 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var errSentinel = errors.New("sentinel")
+
+type customErr struct{ msg string }
+
+func (e *customErr) Error() string { return e.msg }
+
+func TestUnwrapValue(t *testing.T) {
+	if v := Ok(7).UnwrapValue(); v != 7 {
+		t.Fatalf("UnwrapValue() = %d, want 7", v)
+	}
+}
+
+func TestUnwrap_ReturnsError(t *testing.T) {
+	if err := Ok(7).Unwrap(); err != nil {
+		t.Fatalf("Unwrap() on Ok = %v, want nil", err)
+	}
+	if err := Err[int](errSentinel).Unwrap(); err != errSentinel {
+		t.Fatalf("Unwrap() on Err = %v, want %v", err, errSentinel)
+	}
+}
+
+func TestIs(t *testing.T) {
+	wrapped := Err[int](fmt.Errorf("context: %w", errSentinel))
+	if !wrapped.Is(errSentinel) {
+		t.Fatalf("Is(%v) = false, want true for wrapped sentinel", errSentinel)
+	}
+	if Ok(1).Is(errSentinel) {
+		t.Fatal("Is() on Ok Result = true, want false")
+	}
+}
+
+func TestAs(t *testing.T) {
+	r := Err[int](&customErr{msg: "boom"})
+	var target *customErr
+	if !r.As(&target) {
+		t.Fatal("As() = false, want true")
+	}
+	if target.msg != "boom" {
+		t.Fatalf("As() target.msg = %q, want %q", target.msg, "boom")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	wrapped := Wrap(Err[int](errSentinel), "context")
+	if !errors.Is(wrapped.Unwrap(), errSentinel) {
+		t.Fatalf("Wrap() error chain does not contain %v", errSentinel)
+	}
+	if wrapped.Error().Error() != "context: sentinel" {
+		t.Fatalf("Wrap() error = %q, want %q", wrapped.Error().Error(), "context: sentinel")
+	}
+
+	unchanged := Wrap(Ok(1), "context")
+	if !unchanged.IsOk() || unchanged.UnwrapValue() != 1 {
+		t.Fatalf("Wrap(Ok) = %+v, want Ok(1)", unchanged)
+	}
+}
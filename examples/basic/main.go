@@ -3,17 +3,19 @@ package main
 import (
 	"fmt"
 	"os"
+
+	result "github.com/tzzed/go-result"
 )
 
 var errCannotOpenFile = fmt.Errorf("cannot open file")
 
 // OpenFile attempts to open a file at the given path and returns a Result containing the *os.File or an error.
-func OpenFile(path string) Result[*os.File] {
+func OpenFile(path string) result.Result[*os.File] {
 	f, err := os.Open(path)
 	if err != nil {
-		return Err[*os.File](fmt.Errorf("%w: %s", errCannotOpenFile, err.Error()))
+		return result.Err[*os.File](fmt.Errorf("%w: %s", errCannotOpenFile, err.Error()))
 	}
-	return Ok(f)
+	return result.Ok(f)
 }
 
 func main() {
@@ -26,8 +28,8 @@ func main() {
 		fmt.Println("OK: file exists")
 	}
 
-	// Unwrap -> returns *os.File or panic. ok in this case
-	file := res.Unwrap()
+	// UnwrapValue -> returns *os.File or panic. ok in this case
+	file := res.UnwrapValue()
 	fmt.Println("Opened:", file.Name())
 	defer file.Close()
 
@@ -53,7 +55,7 @@ func main() {
 	// ------------------------------------------------------------
 
 	// This will panic if the file does not exist.
-	// OpenFile("unknown.txt").Unwrap()
+	// OpenFile("unknown.txt").UnwrapValue()
 
 	// This will panic with a custom message.
 	// OpenFile("unknown.txt").Expect("file does not exist")
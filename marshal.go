@@ -0,0 +1,148 @@
+package result
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errorFactories holds error constructors registered via RegisterError, keyed by the sentinel
+// message prefix they reconstruct.
+var errorFactories = map[string]func(string) error{}
+
+// RegisterError registers a factory used when decoding an Err Result whose message starts with
+// name, so a sentinel error such as errCannotOpenFile round-trips as itself instead of a plain
+// errors.New value. If more than one registered name matches, the longest one wins.
+func RegisterError(name string, factory func(string) error) {
+	errorFactories[name] = factory
+}
+
+func decodeError(msg string) error {
+	var best string
+	for name := range errorFactories {
+		if strings.HasPrefix(msg, name) && len(name) > len(best) {
+			best = name
+		}
+	}
+	if best == "" {
+		return errors.New(msg)
+	}
+	return errorFactories[best](msg)
+}
+
+// jsonResult is the wire representation used by MarshalJSON/UnmarshalJSON: a tagged object with
+// either an "ok" or an "err" field set.
+type jsonResult struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err string          `json:"err,omitempty"`
+}
+
+// MarshalJSON encodes r as {"ok": <value>} if it is Ok, or {"err": "<message>"} if it is Err.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		return json.Marshal(jsonResult{Err: r.err.Error()})
+	}
+	v, err := json.Marshal(r.value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonResult{Ok: v})
+}
+
+// UnmarshalJSON decodes a Result previously produced by MarshalJSON. An "err" entry is
+// reconstructed via decodeError, which defers to a factory registered with RegisterError when
+// one matches.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var jr jsonResult
+	if err := json.Unmarshal(data, &jr); err != nil {
+		return err
+	}
+	var zero T
+	if jr.Err != "" {
+		r.value = zero
+		r.err = decodeError(jr.Err)
+		return nil
+	}
+	r.value = zero
+	if len(jr.Ok) > 0 && string(jr.Ok) != "null" {
+		if err := json.Unmarshal(jr.Ok, &r.value); err != nil {
+			return err
+		}
+	}
+	r.err = nil
+	return nil
+}
+
+// textOkPrefix and textErrPrefix are the plain-text tags MarshalText writes ahead of the payload
+// and UnmarshalText reads back off. Keeping them printable (rather than a leading control byte)
+// is what makes the encoding safe to drop into log lines or on-disk state: it stays grep-able and
+// doesn't trip tools that choke on embedded NULs.
+const (
+	textOkPrefix  = "ok:"
+	textErrPrefix = "err:"
+)
+
+// escapeTextPayload prefixes s with a backslash if, left alone, it would be misread as one of
+// MarshalText's own tags or as an escaped payload once UnmarshalText strips the real tag off.
+func escapeTextPayload(s string) string {
+	if strings.HasPrefix(s, textOkPrefix) || strings.HasPrefix(s, textErrPrefix) || strings.HasPrefix(s, `\`) {
+		return `\` + s
+	}
+	return s
+}
+
+// unescapeTextPayload reverses escapeTextPayload.
+func unescapeTextPayload(s string) string {
+	return strings.TrimPrefix(s, `\`)
+}
+
+// MarshalText implements encoding.TextMarshaler. When T itself implements
+// encoding.TextMarshaler that is used for Ok values; otherwise the value is rendered with
+// fmt.Sprint. The output is tagged with a leading "ok:"/"err:" prefix, with the payload escaped
+// so a value that happens to look like a tag itself still round-trips correctly.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	if r.err != nil {
+		return []byte(textErrPrefix + escapeTextPayload(r.err.Error())), nil
+	}
+	var payload string
+	if tm, ok := any(r.value).(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		payload = string(b)
+	} else {
+		payload = fmt.Sprint(r.value)
+	}
+	return []byte(textOkPrefix + escapeTextPayload(payload)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the inverse of MarshalText. It requires T
+// to implement encoding.TextUnmarshaler for Ok values to decode.
+func (r *Result[T]) UnmarshalText(text []byte) error {
+	s := string(text)
+
+	if rest, ok := strings.CutPrefix(s, textErrPrefix); ok {
+		var zero T
+		r.value = zero
+		r.err = decodeError(unescapeTextPayload(rest))
+		return nil
+	}
+
+	rest, ok := strings.CutPrefix(s, textOkPrefix)
+	if !ok {
+		return fmt.Errorf("result: text does not start with %q or %q", textOkPrefix, textErrPrefix)
+	}
+
+	tu, ok := any(&r.value).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("result: %T does not implement encoding.TextUnmarshaler", r.value)
+	}
+	if err := tu.UnmarshalText([]byte(unescapeTextPayload(rest))); err != nil {
+		return err
+	}
+	r.err = nil
+	return nil
+}
@@ -0,0 +1,186 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+// textPoint implements encoding.TextMarshaler/TextUnmarshaler so MarshalText/UnmarshalText can be
+// exercised through a T that supplies its own text codec.
+type textPoint struct {
+	X, Y int
+}
+
+func (p textPoint) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d,%d", p.X, p.Y)), nil
+}
+
+func (p *textPoint) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%d,%d", &p.X, &p.Y)
+	return err
+}
+
+func TestResultJSON_RoundTripOkAndErr(t *testing.T) {
+	ok := Ok(point{X: 1, Y: 2})
+	b, err := json.Marshal(ok)
+	if err != nil {
+		t.Fatalf("Marshal(Ok) error: %v", err)
+	}
+
+	var decodedOk Result[point]
+	if err := json.Unmarshal(b, &decodedOk); err != nil {
+		t.Fatalf("Unmarshal(Ok) error: %v", err)
+	}
+	if decodedOk.UnwrapValue() != (point{X: 1, Y: 2}) {
+		t.Fatalf("round-tripped value = %+v, want {1 2}", decodedOk.UnwrapValue())
+	}
+
+	fail := Err[point](errors.New("could not build point"))
+	b, err = json.Marshal(fail)
+	if err != nil {
+		t.Fatalf("Marshal(Err) error: %v", err)
+	}
+
+	var decodedErr Result[point]
+	if err := json.Unmarshal(b, &decodedErr); err != nil {
+		t.Fatalf("Unmarshal(Err) error: %v", err)
+	}
+	if !decodedErr.IsErr() || decodedErr.Error().Error() != "could not build point" {
+		t.Fatalf("round-tripped error = %v, want %q", decodedErr.Error(), "could not build point")
+	}
+}
+
+func TestResultJSON_RegisteredError(t *testing.T) {
+	sentinelErr := errors.New("cannot open file")
+	RegisterError("cannot open file", func(msg string) error { return sentinelErr })
+
+	b, err := json.Marshal(Err[int](sentinelErr))
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	var decoded Result[int]
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if !errors.Is(decoded.Error(), sentinelErr) {
+		t.Fatalf("decoded error = %v, want %v", decoded.Error(), sentinelErr)
+	}
+}
+
+func TestResultJSON_Nested(t *testing.T) {
+	nested := Ok(Ok(7))
+	b, err := json.Marshal(nested)
+	if err != nil {
+		t.Fatalf("Marshal(nested) error: %v", err)
+	}
+
+	var decoded Result[Result[int]]
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("Unmarshal(nested) error: %v", err)
+	}
+	if decoded.UnwrapValue().UnwrapValue() != 7 {
+		t.Fatalf("nested round-trip = %+v, want inner value 7", decoded)
+	}
+}
+
+func TestResultText_RoundTripOkAndErr(t *testing.T) {
+	ok := Ok(textPoint{X: 3, Y: 4})
+	text, err := ok.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(Ok) error: %v", err)
+	}
+
+	var decodedOk Result[textPoint]
+	if err := decodedOk.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(Ok) error: %v", err)
+	}
+	if decodedOk.UnwrapValue() != (textPoint{X: 3, Y: 4}) {
+		t.Fatalf("round-tripped value = %+v, want {3 4}", decodedOk.UnwrapValue())
+	}
+
+	fail := Err[textPoint](errors.New("boom"))
+	text, err = fail.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(Err) error: %v", err)
+	}
+
+	var decodedErr Result[textPoint]
+	if err := decodedErr.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(Err) error: %v", err)
+	}
+	if !decodedErr.IsErr() || decodedErr.Error().Error() != "boom" {
+		t.Fatalf("round-tripped error = %v, want %q", decodedErr.Error(), "boom")
+	}
+}
+
+// TestResultText_OkPayloadLooksLikeAnErrorMessage guards against the framing collision a plain
+// "err: " string prefix would have: an Ok value whose own MarshalText output happens to start
+// with what would have been the Err marker must still decode as Ok.
+func TestResultText_OkPayloadLooksLikeAnErrorMessage(t *testing.T) {
+	tricky := Ok(trickyText("err: not actually an error"))
+	text, err := tricky.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+
+	var decoded Result[trickyText]
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if !decoded.IsOk() {
+		t.Fatalf("decoded = %+v, want Ok despite payload resembling an error marker", decoded)
+	}
+	if decoded.UnwrapValue() != tricky.UnwrapValue() {
+		t.Fatalf("decoded value = %q, want %q", decoded.UnwrapValue(), tricky.UnwrapValue())
+	}
+}
+
+// TestResultText_OkPayloadLooksLikeOkTag does the same check from the other direction: an Ok
+// value whose own text happens to start with the "ok:" tag itself must still round-trip.
+func TestResultText_OkPayloadLooksLikeOkTag(t *testing.T) {
+	tricky := Ok(trickyText("ok:not actually tagged"))
+	text, err := tricky.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+
+	var decoded Result[trickyText]
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if decoded.UnwrapValue() != tricky.UnwrapValue() {
+		t.Fatalf("decoded value = %q, want %q", decoded.UnwrapValue(), tricky.UnwrapValue())
+	}
+}
+
+// TestResultText_IsPrintable confirms MarshalText stays human-readable (no control bytes), so it
+// is actually safe to drop into a log line as the package doc for this request promises.
+func TestResultText_IsPrintable(t *testing.T) {
+	for _, r := range []Result[textPoint]{Ok(textPoint{X: 1, Y: 2}), Err[textPoint](errors.New("boom"))} {
+		text, err := r.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		for _, b := range text {
+			if b < 0x20 || b == 0x7f {
+				t.Fatalf("MarshalText produced non-printable byte %#x in %q", b, text)
+			}
+		}
+	}
+}
+
+type trickyText string
+
+func (t trickyText) MarshalText() ([]byte, error) { return []byte(t), nil }
+
+func (t *trickyText) UnmarshalText(text []byte) error {
+	*t = trickyText(text)
+	return nil
+}
@@ -1,4 +1,9 @@
-package main
+package result
+
+import (
+	"errors"
+	"fmt"
+)
 
 type Result[T any] struct {
 	value T
@@ -30,14 +35,31 @@ func (r Result[T]) Error() error {
 	return r.err
 }
 
-// Unwrap returns the value if the Result is Ok, otherwise it panics with the error message.
-func (r Result[T]) Unwrap() T {
+// UnwrapValue returns the value if the Result is Ok, otherwise it panics with the error message.
+func (r Result[T]) UnwrapValue() T {
 	if r.err != nil {
-		panic("called Unwrap on Err: " + r.err.Error())
+		panic("called UnwrapValue on Err: " + r.err.Error())
 	}
 	return r.value
 }
 
+// Unwrap returns the error stored in the Result, or nil if the Result is Ok. It exists so Result
+// follows the standard library's unwrap convention; prefer the Is and As methods below for
+// matching against a target error, e.g. res.Is(errCannotOpenFile).
+func (r Result[T]) Unwrap() error {
+	return r.err
+}
+
+// Is reports whether the Result's error matches target, delegating to errors.Is.
+func (r Result[T]) Is(target error) bool {
+	return errors.Is(r.err, target)
+}
+
+// As finds the first error in the Result's error chain that matches target, delegating to errors.As.
+func (r Result[T]) As(target any) bool {
+	return errors.As(r.err, target)
+}
+
 // Expect retrieves the value if the Result is Ok; otherwise, it panics with the provided message and the error.
 func (r Result[T]) Expect(msg string) T {
 	if r.err != nil {
@@ -62,3 +84,49 @@ func (r Result[T]) UnwrapOrErr(newErr error) (T, error) {
 	}
 	return r.value, nil
 }
+
+// Combinators ----------------------------------------------------------
+
+// Map applies f to the value of r if it is Ok, wrapping f's return value in a new Result.
+// If r is Err, the error is propagated unchanged and f is never called.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// MapErr applies f to the error of r if it is Err, wrapping f's return value in a new Result.
+// If r is Ok, the value is propagated unchanged and f is never called.
+func MapErr[T any](r Result[T], f func(error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](f(r.err))
+}
+
+// AndThen applies f to the value of r if it is Ok, returning the Result produced by f.
+// If r is Err, the error is propagated unchanged and f is never called.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
+
+// Or returns r if it is Ok, otherwise it returns alt.
+func Or[T any](r Result[T], alt Result[T]) Result[T] {
+	if r.err != nil {
+		return alt
+	}
+	return r
+}
+
+// Wrap adds context to an Err Result by wrapping its error with msg using fmt.Errorf's %w verb,
+// so the original error remains reachable via errors.Is/errors.As. Ok Results are returned unchanged.
+func Wrap[T any](r Result[T], msg string) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](fmt.Errorf("%s: %w", msg, r.err))
+}
@@ -0,0 +1,60 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	doubled := Map(Ok(21), func(v int) int { return v * 2 })
+	if !doubled.IsOk() || doubled.UnwrapValue() != 42 {
+		t.Fatalf("Map(Ok) = %+v, want Ok(42)", doubled)
+	}
+
+	wantErr := errors.New("boom")
+	propagated := Map(Err[int](wantErr), func(v int) int { return v * 2 })
+	if !propagated.IsErr() || propagated.Error() != wantErr {
+		t.Fatalf("Map(Err) = %+v, want Err(%v)", propagated, wantErr)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	unchanged := MapErr(Ok(1), func(e error) error { return errors.New("should not run") })
+	if !unchanged.IsOk() || unchanged.UnwrapValue() != 1 {
+		t.Fatalf("MapErr(Ok) = %+v, want Ok(1)", unchanged)
+	}
+
+	orig := errors.New("boom")
+	wrapped := MapErr(Err[int](orig), func(e error) error { return errors.New("wrapped: " + e.Error()) })
+	if !wrapped.IsErr() || wrapped.Error().Error() != "wrapped: boom" {
+		t.Fatalf("MapErr(Err) = %+v, want Err(wrapped: boom)", wrapped)
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	chained := AndThen(Ok(2), func(v int) Result[int] { return Ok(v + 1) })
+	if !chained.IsOk() || chained.UnwrapValue() != 3 {
+		t.Fatalf("AndThen(Ok) = %+v, want Ok(3)", chained)
+	}
+
+	wantErr := errors.New("boom")
+	short := AndThen(Err[int](wantErr), func(v int) Result[int] {
+		t.Fatal("f should not be called when r is Err")
+		return Ok(v)
+	})
+	if !short.IsErr() || short.Error() != wantErr {
+		t.Fatalf("AndThen(Err) = %+v, want Err(%v)", short, wantErr)
+	}
+}
+
+func TestOr(t *testing.T) {
+	primary := Ok(1)
+	fallback := Ok(2)
+	if got := Or(primary, fallback); got.UnwrapValue() != 1 {
+		t.Fatalf("Or(Ok, Ok) = %+v, want Ok(1)", got)
+	}
+
+	if got := Or(Err[int](errors.New("boom")), fallback); got.UnwrapValue() != 2 {
+		t.Fatalf("Or(Err, Ok) = %+v, want Ok(2)", got)
+	}
+}
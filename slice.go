@@ -0,0 +1,101 @@
+package result
+
+import (
+	"context"
+	"sync"
+)
+
+// Collect returns an Ok Result containing every value in rs, in order, if all elements are Ok.
+// Otherwise it returns the first Err encountered.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.Error())
+		}
+		out = append(out, r.UnwrapValue())
+	}
+	return Ok(out)
+}
+
+// Partition splits rs into the values of its Ok elements and the errors of its Err elements,
+// preserving the order of rs within each slice.
+func Partition[T any](rs []Result[T]) (oks []T, errs []error) {
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.Error())
+			continue
+		}
+		oks = append(oks, r.UnwrapValue())
+	}
+	return oks, errs
+}
+
+// FirstErr returns the error of the first Err element in rs, or nil if every element is Ok.
+func FirstErr[T any](rs []Result[T]) error {
+	for _, r := range rs {
+		if r.IsErr() {
+			return r.Error()
+		}
+	}
+	return nil
+}
+
+// TryAll runs fs in order, stopping at the first thunk whose Result is Err, and collects the
+// values of the rest into an Ok Result.
+func TryAll[T any](fs []func() Result[T]) Result[[]T] {
+	out := make([]T, 0, len(fs))
+	for _, f := range fs {
+		r := f()
+		if r.IsErr() {
+			return Err[[]T](r.Error())
+		}
+		out = append(out, r.UnwrapValue())
+	}
+	return Ok(out)
+}
+
+// TryAllPar runs fs concurrently, passing each thunk a context that is canceled as soon as any
+// thunk returns Err, in the style of errgroup.WithContext. Cooperative thunks should watch
+// ctx.Done() and return early once it fires. TryAllPar itself returns as soon as the first error
+// arrives, without waiting for the remaining thunks to finish; if every thunk returns Ok, it
+// returns their values in the same order as fs.
+func TryAllPar[T any](fs []func(ctx context.Context) Result[T]) Result[[]T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := make([]T, len(fs))
+	errCh := make(chan error, len(fs))
+	allDone := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(len(fs))
+	for i, f := range fs {
+		go func(i int, f func(context.Context) Result[T]) {
+			defer wg.Done()
+			r := f(ctx)
+			if r.IsErr() {
+				errCh <- r.Error()
+				cancel()
+				return
+			}
+			out[i] = r.UnwrapValue()
+		}(i, f)
+	}
+	go func() {
+		wg.Wait()
+		close(allDone)
+	}()
+
+	select {
+	case err := <-errCh:
+		return Err[[]T](err)
+	case <-allDone:
+		select {
+		case err := <-errCh:
+			return Err[[]T](err)
+		default:
+			return Ok(out)
+		}
+	}
+}
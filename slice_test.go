@@ -0,0 +1,131 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCollect(t *testing.T) {
+	if got := Collect([]Result[int]{}); !got.IsOk() || len(got.UnwrapValue()) != 0 {
+		t.Fatalf("Collect(empty) = %+v, want Ok([])", got)
+	}
+
+	allOk := []Result[int]{Ok(1), Ok(2), Ok(3)}
+	if got := Collect(allOk); !got.IsOk() || len(got.UnwrapValue()) != 3 {
+		t.Fatalf("Collect(all-Ok) = %+v, want Ok([1 2 3])", got)
+	}
+
+	wantErr := errors.New("boom")
+	mixed := []Result[int]{Ok(1), Err[int](wantErr), Ok(3)}
+	if got := Collect(mixed); !got.IsErr() || got.Error() != wantErr {
+		t.Fatalf("Collect(mixed) = %+v, want Err(%v)", got, wantErr)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	err1 := errors.New("e1")
+	oks, errs := Partition([]Result[int]{Ok(1), Err[int](err1), Ok(2)})
+	if len(oks) != 2 || oks[0] != 1 || oks[1] != 2 {
+		t.Fatalf("Partition oks = %v, want [1 2]", oks)
+	}
+	if len(errs) != 1 || errs[0] != err1 {
+		t.Fatalf("Partition errs = %v, want [%v]", errs, err1)
+	}
+
+	oks, errs = Partition([]Result[int]{})
+	if len(oks) != 0 || len(errs) != 0 {
+		t.Fatalf("Partition(empty) = %v, %v, want empty slices", oks, errs)
+	}
+}
+
+func TestFirstErr(t *testing.T) {
+	if err := FirstErr([]Result[int]{Ok(1), Ok(2)}); err != nil {
+		t.Fatalf("FirstErr(all-Ok) = %v, want nil", err)
+	}
+
+	want := errors.New("boom")
+	if err := FirstErr([]Result[int]{Ok(1), Err[int](want), Err[int](errors.New("second"))}); err != want {
+		t.Fatalf("FirstErr(mixed) = %v, want %v", err, want)
+	}
+
+	if err := FirstErr([]Result[int]{}); err != nil {
+		t.Fatalf("FirstErr(empty) = %v, want nil", err)
+	}
+}
+
+func TestTryAll(t *testing.T) {
+	calls := 0
+	fs := []func() Result[int]{
+		func() Result[int] { calls++; return Ok(1) },
+		func() Result[int] { calls++; return Err[int](errors.New("boom")) },
+		func() Result[int] { calls++; return Ok(3) },
+	}
+
+	got := TryAll(fs)
+	if !got.IsErr() || got.Error().Error() != "boom" {
+		t.Fatalf("TryAll(mixed) = %+v, want Err(boom)", got)
+	}
+	if calls != 2 {
+		t.Fatalf("TryAll ran %d thunks, want 2 (stop at first error)", calls)
+	}
+
+	got = TryAll([]func() Result[int]{})
+	if !got.IsOk() || len(got.UnwrapValue()) != 0 {
+		t.Fatalf("TryAll(empty) = %+v, want Ok([])", got)
+	}
+}
+
+func TestTryAllPar_AllOk(t *testing.T) {
+	fs := make([]func(context.Context) Result[int], 5)
+	for i := range fs {
+		i := i
+		fs[i] = func(ctx context.Context) Result[int] { return Ok(i) }
+	}
+
+	got := TryAllPar(fs)
+	if !got.IsOk() {
+		t.Fatalf("TryAllPar(all-Ok) = %+v, want Ok", got)
+	}
+	for i, v := range got.UnwrapValue() {
+		if v != i {
+			t.Fatalf("TryAllPar(all-Ok)[%d] = %d, want %d", i, v, i)
+		}
+	}
+}
+
+func TestTryAllPar_Empty(t *testing.T) {
+	got := TryAllPar([]func(context.Context) Result[int]{})
+	if !got.IsOk() || len(got.UnwrapValue()) != 0 {
+		t.Fatalf("TryAllPar(empty) = %+v, want Ok([])", got)
+	}
+}
+
+func TestTryAllPar_CancelsRemainingOnError(t *testing.T) {
+	canceled := make(chan struct{}, 1)
+	fs := []func(context.Context) Result[int]{
+		func(ctx context.Context) Result[int] {
+			return Err[int](errors.New("boom"))
+		},
+		func(ctx context.Context) Result[int] {
+			select {
+			case <-ctx.Done():
+				canceled <- struct{}{}
+			case <-time.After(time.Second):
+			}
+			return Ok(1)
+		},
+	}
+
+	got := TryAllPar(fs)
+	if !got.IsErr() || got.Error().Error() != "boom" {
+		t.Fatalf("TryAllPar(mixed) = %+v, want Err(boom)", got)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("context was not canceled after a sibling thunk returned Err")
+	}
+}
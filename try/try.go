@@ -0,0 +1,71 @@
+// Package try turns Result-based error handling into linear, exception-like
+// control flow, in the style of github.com/dsnet/try. E (and its multi-value
+// variants E2/E3) unwrap a Result or panic with a private sentinel carrying
+// the error; a deferred call to Handle or HandleF recovers that sentinel and
+// assigns the error to an out-parameter, re-panicking anything else so
+// unrelated panics are never swallowed.
+package try
+
+import (
+	result "github.com/tzzed/go-result"
+)
+
+// sentinel wraps an error produced by E, E2, or E3. It is unexported so that
+// code outside this package cannot construct one and spoof a recover in
+// Handle or HandleF.
+type sentinel struct {
+	err error
+}
+
+// E returns the value of r if it is Ok, otherwise it panics with a sentinel
+// wrapping r's error. Call it only from a function that defers Handle or
+// HandleF.
+func E[T any](r result.Result[T]) T {
+	if r.IsErr() {
+		panic(sentinel{err: r.Error()})
+	}
+	return r.UnwrapValue()
+}
+
+// E2 is the two-Result variant of E.
+func E2[T, U any](r1 result.Result[T], r2 result.Result[U]) (T, U) {
+	return E(r1), E(r2)
+}
+
+// E3 is the three-Result variant of E.
+func E3[T, U, V any](r1 result.Result[T], r2 result.Result[U], r3 result.Result[V]) (T, U, V) {
+	return E(r1), E(r2), E(r3)
+}
+
+// Handle recovers a sentinel panic raised by E, E2, or E3 and assigns its
+// error to *errPtr. Any other panic value is re-panicked unchanged. Handle
+// must itself be deferred directly (e.g. `defer Handle(&err)`) — recover
+// only stops a panic when called by the deferred function itself, not by a
+// function that deferred function calls.
+func Handle(errPtr *error) {
+	recoverInto(recover(), errPtr)
+}
+
+// HandleF behaves like Handle but additionally runs cleanup before
+// returning, whether or not an error was recovered. Like Handle, HandleF
+// must be deferred directly for recover to see an in-flight panic.
+func HandleF(errPtr *error, cleanup func()) {
+	if cleanup != nil {
+		defer cleanup()
+	}
+	recoverInto(recover(), errPtr)
+}
+
+// recoverInto assigns the error carried by a sentinel panic value v to
+// *errPtr, or re-panics v if it isn't a sentinel. v is nil when there was no
+// panic to recover.
+func recoverInto(v any, errPtr *error) {
+	if v == nil {
+		return
+	}
+	s, ok := v.(sentinel)
+	if !ok {
+		panic(v)
+	}
+	*errPtr = s.err
+}
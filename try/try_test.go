@@ -0,0 +1,73 @@
+package try_test
+
+import (
+	"errors"
+	"testing"
+
+	result "github.com/tzzed/go-result"
+	"github.com/tzzed/go-result/try"
+)
+
+func TestE_PassThrough(t *testing.T) {
+	v := try.E(result.Ok(42))
+	if v != 42 {
+		t.Fatalf("E() = %d, want 42", v)
+	}
+}
+
+func TestHandle_CapturesError(t *testing.T) {
+	wantErr := errors.New("boom")
+
+	run := func() (err error) {
+		defer try.Handle(&err)
+		_ = try.E(result.Err[int](wantErr))
+		t.Fatal("unreachable: E should have panicked")
+		return nil
+	}
+
+	if err := run(); !errors.Is(err, wantErr) {
+		t.Fatalf("run() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestHandleF_RunsCleanupOnOkAndErr(t *testing.T) {
+	for _, wantErr := range []error{nil, errors.New("boom")} {
+		cleaned := false
+		run := func() (err error) {
+			defer try.HandleF(&err, func() { cleaned = true })
+			if wantErr != nil {
+				_ = try.E(result.Err[int](wantErr))
+			}
+			return nil
+		}
+
+		if err := run(); !errors.Is(err, wantErr) {
+			t.Fatalf("run() error = %v, want %v", err, wantErr)
+		}
+		if !cleaned {
+			t.Fatalf("cleanup was not invoked (wantErr=%v)", wantErr)
+		}
+	}
+}
+
+func TestHandle_RepanicsForeignValue(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r != "not a sentinel" {
+			t.Fatalf("recover() = %v, want foreign panic value to propagate", r)
+		}
+	}()
+
+	func() {
+		var err error
+		defer try.Handle(&err)
+		panic("not a sentinel")
+	}()
+}
+
+func TestE2(t *testing.T) {
+	a, b := try.E2(result.Ok(1), result.Ok("two"))
+	if a != 1 || b != "two" {
+		t.Fatalf("E2() = %v, %v, want 1, two", a, b)
+	}
+}